@@ -6,10 +6,72 @@ pub struct ToolBindingSetResponse {
     pub names: Vec<String>,
 }
 
+#[derive(Debug, Serialize)]
+#[serde(rename_all = "camelCase")]
+pub struct AgentTemplateResponse {
+    pub id: String,
+    pub name: String,
+    pub role: String,
+    pub description: String,
+}
+
+#[derive(Debug, Serialize)]
+#[serde(rename_all = "camelCase")]
+pub struct AgentPresetResponse {
+    pub id: String,
+    pub name: String,
+    pub role: String,
+    pub persona: String,
+    pub tool_names: Vec<String>,
+}
+
 #[tauri::command]
-pub fn default_agent_tool_bindings() -> ToolBindingSetResponse {
-    let names = nuka_tools::registry::ToolBindingSet::from_names(["codex", "git", "search_knowledge"])
-        .into_vec();
+pub fn default_agent_tool_bindings(role: Option<String>) -> ToolBindingSetResponse {
+    let names = match role {
+        Some(role) => nuka_tools::registry::ToolBindingSet::for_role(&role),
+        None => nuka_tools::registry::ToolBindingSet::default_bundle(),
+    }
+    .into_vec();
 
     ToolBindingSetResponse { names }
 }
+
+#[tauri::command]
+pub fn agent_templates() -> Vec<AgentTemplateResponse> {
+    nuka_domain::agent::AGENT_TEMPLATES
+        .iter()
+        .map(|template| AgentTemplateResponse {
+            id: template.id.to_string(),
+            name: template.name.to_string(),
+            role: template.role.to_string(),
+            description: template.description.to_string(),
+        })
+        .collect()
+}
+
+#[tauri::command]
+pub fn create_agent_from_template(
+    template_id: String,
+    name: Option<String>,
+) -> Result<AgentPresetResponse, String> {
+    let template = nuka_domain::agent::AgentTemplate::find(&template_id)
+        .ok_or_else(|| format!("unknown agent template: {template_id}"))?;
+    let mut preset = nuka_domain::agent::AgentPreset::from_template(template, name.as_deref());
+    preset.tool_bindings = nuka_tools::registry::ToolBindingSet::for_role(&preset.role)
+        .into_vec()
+        .into_iter()
+        .map(nuka_domain::tool::AgentToolBinding::allowed)
+        .collect();
+
+    Ok(AgentPresetResponse {
+        id: preset.id,
+        name: preset.name,
+        role: preset.role,
+        persona: preset.persona,
+        tool_names: preset
+            .tool_bindings
+            .into_iter()
+            .map(|binding| binding.tool_id)
+            .collect(),
+    })
+}
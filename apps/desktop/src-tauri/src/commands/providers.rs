@@ -16,3 +16,27 @@ pub fn provider_registry() -> ProviderRegistryResponse {
         names: registry.names(),
     }
 }
+
+#[derive(Debug, Serialize)]
+#[serde(rename_all = "camelCase")]
+pub struct ProviderCapabilitiesResponse {
+    pub provider_id: String,
+    pub tools: bool,
+    pub streaming: bool,
+    pub vision: bool,
+    pub json_mode: bool,
+}
+
+#[tauri::command]
+pub fn provider_capabilities(provider_id: String) -> Result<ProviderCapabilitiesResponse, String> {
+    let capabilities = nuka_integrations::providers::capabilities(&provider_id)
+        .ok_or_else(|| format!("no declared capabilities for provider: {provider_id}"))?;
+
+    Ok(ProviderCapabilitiesResponse {
+        provider_id,
+        tools: capabilities.tools,
+        streaming: capabilities.streaming,
+        vision: capabilities.vision,
+        json_mode: capabilities.json_mode,
+    })
+}
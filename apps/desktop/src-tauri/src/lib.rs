@@ -7,11 +7,14 @@ pub fn run() {
     tauri::Builder::default()
         .manage(app_state::AppState::default())
         .invoke_handler(tauri::generate_handler![
+            commands::agents::agent_templates,
+            commands::agents::create_agent_from_template,
             commands::agents::default_agent_tool_bindings,
             commands::app::close_policy_minimizes_to_tray,
             commands::chat::route_world_prompt,
             commands::knowledge::default_knowledge_library,
             commands::memory::memory_promotion_policy,
+            commands::providers::provider_capabilities,
             commands::providers::provider_registry,
             commands::tools::integrated_tool_output_policy,
             commands::workflow::start_workflow_session,
@@ -4,7 +4,11 @@ import { SettingsPage } from "./SettingsPage";
 import { findText, renderIntoDocument } from "@/test/render";
 
 vi.mock("@tauri-apps/api/core", () => ({
-  invoke: vi.fn(async () => ({ count: 3, names: ["OpenAI", "Anthropic", "Ollama"] })),
+  invoke: vi.fn(async (command: string) =>
+    command === "provider_capabilities"
+      ? { providerId: "openai", tools: true, streaming: true, vision: true, jsonMode: true }
+      : { count: 3, names: ["OpenAI", "Anthropic", "Ollama"] },
+  ),
 }));
 
 const cleanups: Array<() => Promise<void>> = [];
@@ -117,6 +121,18 @@ describe("SettingsPage", () => {
     expect(window.localStorage.getItem("nuka.settings.providers")).toContain("OpenRouter");
   });
 
+  it("shows declared capabilities for the default provider", async () => {
+    const view = await renderIntoDocument(<SettingsPage />);
+    cleanups.push(view.cleanup);
+
+    await act(async () => {
+      await Promise.resolve();
+    });
+
+    expect(findText(view.container, "Default Provider Capabilities")).toBeTruthy();
+    expect(findText(view.container, "Tools, Streaming, Vision, JSON mode")).toBeTruthy();
+  });
+
   it("switches the expanded section and persists runtime toggles", async () => {
     const view = await renderIntoDocument(<SettingsPage />);
     cleanups.push(view.cleanup);
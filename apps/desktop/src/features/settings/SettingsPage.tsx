@@ -9,6 +9,14 @@ type ProviderRegistryResponse = {
   names: string[];
 };
 
+type ProviderCapabilitiesResponse = {
+  providerId: string;
+  tools: boolean;
+  streaming: boolean;
+  vision: boolean;
+  jsonMode: boolean;
+};
+
 type SettingsSectionKey = "providers" | "appearance" | "runtime";
 
 type SectionGuide = {
@@ -620,6 +628,7 @@ export function SettingsPage() {
   const [runtimeSettings, setRuntimeSettings] = useState<RuntimeSettings>(() => readStoredState(RUNTIME_STORAGE_KEY, DEFAULT_RUNTIME_SETTINGS));
   const [savedRuntimeSettings, setSavedRuntimeSettings] = useState<RuntimeSettings>(() => readStoredState(RUNTIME_STORAGE_KEY, DEFAULT_RUNTIME_SETTINGS));
   const [registryHydrated, setRegistryHydrated] = useState(false);
+  const [defaultCapabilities, setDefaultCapabilities] = useState<ProviderCapabilitiesResponse | null>(null);
 
   useEffect(() => {
     let alive = true;
@@ -652,6 +661,35 @@ export function SettingsPage() {
     setRegistryHydrated(true);
   }, [registry.names, registryHydrated]);
 
+  const defaultProviderName =
+    savedProviderSettings.providers.find((provider) => provider.id === savedProviderSettings.defaultProviderId)?.name ?? "";
+
+  useEffect(() => {
+    const providerId = capabilityProviderId(defaultProviderName);
+    if (!providerId) {
+      setDefaultCapabilities(null);
+      return;
+    }
+
+    let alive = true;
+
+    void invoke<ProviderCapabilitiesResponse>("provider_capabilities", { providerId })
+      .then((response) => {
+        if (alive) {
+          setDefaultCapabilities(response);
+        }
+      })
+      .catch(() => {
+        if (alive) {
+          setDefaultCapabilities(null);
+        }
+      });
+
+    return () => {
+      alive = false;
+    };
+  }, [defaultProviderName]);
+
   const providerDirty = useMemo(
     () => serializeState(providerSettings) !== serializeState(savedProviderSettings),
     [providerSettings, savedProviderSettings],
@@ -854,12 +892,42 @@ export function SettingsPage() {
           <Card description={activeSectionConfig.guide.whatYouEdit} title="What You Edit" tone="soft" />
           <Card description={activeSectionConfig.guide.recommendedDefault} title="Recommended Default" tone="soft" />
           <Card description={`Registry discovered ${registry.count} provider${registry.count === 1 ? "" : "s"}.`} title="Registry Snapshot" tone="soft" />
+          <Card description={describeCapabilities(defaultCapabilities)} title="Default Provider Capabilities" tone="soft" />
         </Inspector>
       </div>
     </div>
   );
 }
 
+function capabilityProviderId(name: string) {
+  const normalized = name.toLowerCase();
+
+  if (normalized.includes("openai")) {
+    return "openai";
+  }
+
+  if (normalized.includes("anthropic")) {
+    return "anthropic";
+  }
+
+  return null;
+}
+
+function describeCapabilities(capabilities: ProviderCapabilitiesResponse | null) {
+  if (!capabilities) {
+    return "No declared capabilities for the default provider.";
+  }
+
+  const supported = [
+    capabilities.tools ? "Tools" : null,
+    capabilities.streaming ? "Streaming" : null,
+    capabilities.vision ? "Vision" : null,
+    capabilities.jsonMode ? "JSON mode" : null,
+  ].filter((label): label is string => label !== null);
+
+  return supported.length > 0 ? supported.join(", ") : "No declared capabilities for the default provider.";
+}
+
 function buildProviderSettings(names: string[]): ProviderSettings {
   const sourceNames = names.length > 0 ? names : DEFAULT_PROVIDER_NAMES;
   const providers = sourceNames.map((name, index) => createProviderEntry(name, index));
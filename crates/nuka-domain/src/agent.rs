@@ -2,5 +2,84 @@
 pub struct AgentPreset {
     pub id: String,
     pub name: String,
+    pub role: String,
+    pub persona: String,
     pub tool_bindings: Vec<crate::tool::AgentToolBinding>,
 }
+
+#[derive(Debug, Clone, Copy, PartialEq, Eq)]
+pub struct AgentTemplate {
+    pub id: &'static str,
+    pub name: &'static str,
+    pub role: &'static str,
+    pub description: &'static str,
+    pub persona: &'static str,
+}
+
+pub const AGENT_TEMPLATES: &[AgentTemplate] = &[
+    AgentTemplate {
+        id: "researcher",
+        name: "Researcher",
+        role: "researcher",
+        description: "Synthesis and retrieval",
+        persona: "Gathers sources, cross-checks facts, and writes concise cited summaries.",
+    },
+    AgentTemplate {
+        id: "reviewer",
+        name: "Reviewer",
+        role: "reviewer",
+        description: "Checks quality and policy",
+        persona: "Reviews drafts and changes for correctness, clarity, and policy issues.",
+    },
+    AgentTemplate {
+        id: "coordinator",
+        name: "Coordinator",
+        role: "coordinator",
+        description: "Routes tasks and memory",
+        persona: "Breaks requests into tasks, assigns them, and keeps shared memory tidy.",
+    },
+    AgentTemplate {
+        id: "translator",
+        name: "Translator",
+        role: "translator",
+        description: "Faithful translation between languages",
+        persona: "Translates text faithfully, keeping tone, terminology, and formatting intact.",
+    },
+    AgentTemplate {
+        id: "poet",
+        name: "Poet",
+        role: "poet",
+        description: "Verse and creative writing",
+        persona: "Answers in verse, favouring vivid imagery and a consistent meter.",
+    },
+    AgentTemplate {
+        id: "ops",
+        name: "Ops",
+        role: "ops",
+        description: "Runbooks and system checks",
+        persona: "Follows runbooks, inspects system state, and reports issues with next steps.",
+    },
+];
+
+impl AgentTemplate {
+    pub fn find(id: &str) -> Option<&'static AgentTemplate> {
+        AGENT_TEMPLATES.iter().find(|template| template.id == id)
+    }
+}
+
+impl AgentPreset {
+    pub fn from_template(template: &AgentTemplate, name: Option<&str>) -> Self {
+        let name = name
+            .map(str::trim)
+            .filter(|name| !name.is_empty())
+            .unwrap_or(template.name);
+
+        Self {
+            id: uuid::Uuid::new_v4().to_string(),
+            name: name.to_string(),
+            role: template.role.to_string(),
+            persona: template.persona.to_string(),
+            tool_bindings: Vec::new(),
+        }
+    }
+}
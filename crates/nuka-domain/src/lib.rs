@@ -6,6 +6,7 @@ pub mod workflow;
 
 #[cfg(test)]
 mod tests {
+    use crate::agent::{AgentPreset, AgentTemplate};
     use crate::workflow::{WorkflowTemplate, WorkflowVisibility};
 
     #[test]
@@ -13,4 +14,25 @@ mod tests {
         let workflow = WorkflowTemplate::saved("code-review");
         assert_eq!(workflow.visibility, WorkflowVisibility::Private);
     }
+
+    #[test]
+    fn template_instantiation_carries_template_persona() {
+        let template = AgentTemplate::find("translator").unwrap();
+        let preset = AgentPreset::from_template(template, None);
+
+        assert_eq!(preset.name, "Translator");
+        assert_eq!(preset.role, "translator");
+        assert_eq!(preset.persona, template.persona);
+    }
+
+    #[test]
+    fn template_instantiation_accepts_name_override() {
+        let template = AgentTemplate::find("researcher").unwrap();
+        let first = AgentPreset::from_template(template, Some("Nora"));
+        let second = AgentPreset::from_template(template, Some("  "));
+
+        assert_eq!(first.name, "Nora");
+        assert_eq!(second.name, "Researcher");
+        assert_ne!(first.id, second.id);
+    }
 }
@@ -1 +1,67 @@
 pub const ADAPTER_ID: &str = "discord";
+
+pub fn format_reply(markdown: &str) -> String {
+    crate::markdown::render(
+        markdown,
+        |info| format!("```{info}"),
+        str::to_string,
+        format_line,
+    )
+}
+
+fn format_line(line: &str) -> String {
+    match crate::markdown::heading(line) {
+        Some((level, text)) if level > 3 && !text.is_empty() => format!(
+            "**{}**",
+            crate::markdown::map_code_spans(text, format_inline, str::to_string)
+        ),
+        _ => crate::markdown::map_code_spans(line, format_inline, str::to_string),
+    }
+}
+
+fn format_inline(text: &str) -> String {
+    crate::markdown::replace_pairs(text, "__", "**", "**")
+}
+
+#[cfg(test)]
+mod tests {
+    #[test]
+    fn canonical_markdown_keeps_discord_native_syntax() {
+        let reply =
+            crate::discord::format_reply("# Plan\n#### Detail\n**bold** and __strong__ `__raw__`");
+        assert_eq!(
+            reply,
+            "# Plan\n**Detail**\n**bold** and **strong** `__raw__`"
+        );
+    }
+
+    #[test]
+    fn code_fences_keep_language_and_are_closed() {
+        let reply = crate::discord::format_reply("```rust\nfn main() {}");
+        assert_eq!(reply, "```rust\nfn main() {}\n```");
+    }
+
+    #[test]
+    fn single_line_triple_backtick_span_is_not_a_fence() {
+        let reply = crate::discord::format_reply("```inline``` text\n__after__");
+        assert_eq!(reply, "```inline``` text\n**after**");
+    }
+
+    #[test]
+    fn headings_with_inline_code_are_detected_on_the_whole_line() {
+        let reply = crate::discord::format_reply("#### Use `__x__` now\nrun `a` #### deep");
+        assert_eq!(reply, "**Use `__x__` now**\nrun `a` #### deep");
+    }
+
+    #[test]
+    fn four_backtick_fence_is_closed() {
+        let reply = crate::discord::format_reply("````\ncode\n```python inside\n````\n__after__");
+        assert_eq!(reply, "```\ncode\n```python inside\n```\n**after**");
+    }
+
+    #[test]
+    fn underscores_inside_identifiers_are_not_emphasis() {
+        let reply = crate::discord::format_reply("call foo__bar__baz or __this__");
+        assert_eq!(reply, "call foo__bar__baz or **this**");
+    }
+}
@@ -1,4 +1,5 @@
 pub mod discord;
+mod markdown;
 pub mod providers;
 pub mod slack;
 
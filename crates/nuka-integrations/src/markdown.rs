@@ -0,0 +1,180 @@
+pub(crate) fn render(
+    markdown: &str,
+    fence: impl Fn(&str) -> String,
+    code: impl Fn(&str) -> String,
+    line: impl Fn(&str) -> String,
+) -> String {
+    let mut lines = Vec::new();
+    let mut open_ticks = None;
+
+    for raw in markdown.lines() {
+        if let Some(ticks) = open_ticks {
+            if is_fence_closer(raw, ticks) {
+                lines.push("```".to_string());
+                open_ticks = None;
+            } else {
+                lines.push(code(raw));
+            }
+        } else if let Some((ticks, info)) = fence_opener(raw) {
+            lines.push(fence(info));
+            open_ticks = Some(ticks);
+        } else {
+            lines.push(line(raw));
+        }
+    }
+
+    if open_ticks.is_some() {
+        lines.push("```".to_string());
+    }
+
+    lines.join("\n")
+}
+
+pub(crate) fn heading(line: &str) -> Option<(usize, &str)> {
+    let trimmed = line.trim_start();
+    let level = trimmed.chars().take_while(|ch| *ch == '#').count();
+    let text = trimmed[level..].strip_prefix(' ')?;
+
+    (1..=6).contains(&level).then_some((level, text.trim()))
+}
+
+pub(crate) fn map_code_spans(
+    line: &str,
+    text: impl Fn(&str) -> String,
+    code: impl Fn(&str) -> String,
+) -> String {
+    let parts = line.split('`').collect::<Vec<_>>();
+    let balanced = parts.len() % 2 == 1;
+
+    parts
+        .iter()
+        .enumerate()
+        .map(|(index, part)| {
+            if index % 2 == 1 && (balanced || index + 1 < parts.len()) {
+                code(part)
+            } else {
+                text(part)
+            }
+        })
+        .collect::<Vec<_>>()
+        .join("`")
+}
+
+pub(crate) fn replace_pairs(text: &str, delim: &str, open: &str, close: &str) -> String {
+    let intraword = !delim.starts_with('_');
+    let mut out = String::new();
+    let mut pos = 0;
+
+    while let Some(found) = text[pos..].find(delim) {
+        let start = pos + found;
+        let inner = start + delim.len();
+        let end = text[inner..].find(delim).map(|end| inner + end);
+        match end {
+            Some(end)
+                if is_wrapped(&text[inner..end])
+                    && (intraword
+                        || (!word_before(text, start) && !word_after(text, end + delim.len()))) =>
+            {
+                out.push_str(&text[pos..start]);
+                out.push_str(open);
+                out.push_str(&text[inner..end]);
+                out.push_str(close);
+                pos = end + delim.len();
+            }
+            _ => {
+                out.push_str(&text[pos..inner]);
+                pos = inner;
+            }
+        }
+    }
+
+    out.push_str(&text[pos..]);
+    out
+}
+
+pub(crate) fn replace_links(text: &str, mut link: impl FnMut(&str, &str) -> String) -> String {
+    let mut out = String::new();
+    let mut rest = text;
+
+    while let Some(start) = rest.find('[') {
+        let after = &rest[start + 1..];
+        match parse_link(after) {
+            Some((label, url, consumed)) => {
+                out.push_str(&rest[..start]);
+                out.push_str(&link(label, url));
+                rest = &after[consumed..];
+            }
+            None => {
+                out.push_str(&rest[..start + 1]);
+                rest = after;
+            }
+        }
+    }
+
+    out.push_str(rest);
+    out
+}
+
+fn fence_opener(line: &str) -> Option<(usize, &str)> {
+    let trimmed = line.trim_start();
+    let ticks = backtick_run(trimmed);
+    let info = &trimmed[ticks..];
+
+    (ticks >= 3 && !info.contains('`')).then_some((ticks, info.trim()))
+}
+
+fn is_fence_closer(line: &str, open_ticks: usize) -> bool {
+    let trimmed = line.trim();
+    let ticks = backtick_run(trimmed);
+
+    ticks >= open_ticks && ticks == trimmed.len()
+}
+
+fn backtick_run(text: &str) -> usize {
+    text.chars().take_while(|ch| *ch == '`').count()
+}
+
+fn parse_link(after: &str) -> Option<(&str, &str, usize)> {
+    let label_end = after.find("](")?;
+    let label = &after[..label_end];
+    if label.is_empty() || label.contains('[') {
+        return None;
+    }
+
+    let url_start = label_end + 2;
+    let mut depth = 0usize;
+    for (offset, ch) in after[url_start..].char_indices() {
+        match ch {
+            '(' => depth += 1,
+            ')' if depth == 0 => {
+                let url = &after[url_start..url_start + offset];
+                return (!url.is_empty()).then_some((label, url, url_start + offset + 1));
+            }
+            ')' => depth -= 1,
+            ch if ch.is_whitespace() => return None,
+            _ => {}
+        }
+    }
+
+    None
+}
+
+fn word_before(text: &str, index: usize) -> bool {
+    text[..index]
+        .chars()
+        .next_back()
+        .is_some_and(char::is_alphanumeric)
+}
+
+fn word_after(text: &str, index: usize) -> bool {
+    text[index..]
+        .chars()
+        .next()
+        .is_some_and(char::is_alphanumeric)
+}
+
+fn is_wrapped(inner: &str) -> bool {
+    !inner.is_empty()
+        && !inner.starts_with(char::is_whitespace)
+        && !inner.ends_with(char::is_whitespace)
+}
@@ -1 +1,10 @@
+use crate::providers::ProviderCapabilities;
+
 pub const PROVIDER_ID: &str = "anthropic";
+
+pub const CAPABILITIES: ProviderCapabilities = ProviderCapabilities {
+    tools: true,
+    streaming: true,
+    vision: true,
+    json_mode: false,
+};
@@ -1,6 +1,22 @@
 pub mod anthropic;
 pub mod openai;
 
+#[derive(Debug, Clone, Copy, PartialEq, Eq)]
+pub struct ProviderCapabilities {
+    pub tools: bool,
+    pub streaming: bool,
+    pub vision: bool,
+    pub json_mode: bool,
+}
+
+pub fn capabilities(provider_id: &str) -> Option<ProviderCapabilities> {
+    match provider_id {
+        anthropic::PROVIDER_ID => Some(anthropic::CAPABILITIES),
+        openai::PROVIDER_ID => Some(openai::CAPABILITIES),
+        _ => None,
+    }
+}
+
 #[derive(Default)]
 pub struct ProviderRegistry(Vec<String>);
 
@@ -21,4 +37,19 @@ mod tests {
         let registry = crate::providers::ProviderRegistry::default();
         assert_eq!(registry.len(), 0);
     }
+
+    #[test]
+    fn known_providers_declare_capabilities() {
+        let anthropic = crate::providers::capabilities("anthropic").unwrap();
+        let openai = crate::providers::capabilities("openai").unwrap();
+
+        assert!(anthropic.tools && anthropic.streaming && anthropic.vision);
+        assert!(!anthropic.json_mode);
+        assert!(openai.tools && openai.streaming && openai.vision && openai.json_mode);
+    }
+
+    #[test]
+    fn unknown_provider_has_no_declared_capabilities() {
+        assert!(crate::providers::capabilities("xfyun").is_none());
+    }
 }
@@ -1 +1,164 @@
 pub const ADAPTER_ID: &str = "slack";
+
+const BOLD_MARK: &str = "\u{0}";
+const LINK_MARK: char = '\u{1}';
+
+pub fn format_reply(markdown: &str) -> String {
+    crate::markdown::render(markdown, format_fence, escape, format_line)
+}
+
+fn format_fence(info: &str) -> String {
+    let is_language = info
+        .chars()
+        .all(|ch| ch.is_ascii_alphanumeric() || "+#-_.".contains(ch));
+
+    if is_language {
+        "```".to_string()
+    } else {
+        format!("```\n{}", escape(info))
+    }
+}
+
+fn format_line(line: &str) -> String {
+    if let Some(quoted) = line.strip_prefix('>') {
+        return match quoted.strip_prefix(' ') {
+            Some(quoted) => format!("> {}", format_line(quoted)),
+            None => format!(">{}", format_line(quoted)),
+        };
+    }
+
+    match crate::markdown::heading(line) {
+        Some((_, text)) if !text.is_empty() => format!(
+            "*{}*",
+            crate::markdown::map_code_spans(text, |part| format_text(part, ""), escape)
+        ),
+        _ => crate::markdown::map_code_spans(line, |part| format_text(part, "*"), escape),
+    }
+}
+
+fn format_text(text: &str, bold: &str) -> String {
+    let mut links = Vec::new();
+    let text = crate::markdown::replace_links(text, |label, url| {
+        links.push(format!(
+            "<{}|{}>",
+            escape(url),
+            format_emphasis(&escape(label), bold)
+        ));
+        format!("{LINK_MARK}{}{LINK_MARK}", links.len() - 1)
+    });
+
+    let mut text = format_emphasis(&escape(&text), bold);
+    for (index, link) in links.iter().enumerate() {
+        text = text.replace(&format!("{LINK_MARK}{index}{LINK_MARK}"), link);
+    }
+
+    text
+}
+
+fn format_emphasis(text: &str, bold: &str) -> String {
+    let text = crate::markdown::replace_pairs(
+        text,
+        "***",
+        &format!("{BOLD_MARK}_"),
+        &format!("_{BOLD_MARK}"),
+    );
+    let text = crate::markdown::replace_pairs(&text, "**", BOLD_MARK, BOLD_MARK);
+    let text = crate::markdown::replace_pairs(&text, "__", BOLD_MARK, BOLD_MARK);
+    let text = crate::markdown::replace_pairs(&text, "*", "_", "_");
+    let text = crate::markdown::replace_pairs(&text, "~~", "~", "~");
+
+    text.replace(BOLD_MARK, bold)
+}
+
+fn escape(text: &str) -> String {
+    text.replace('&', "&amp;")
+        .replace('<', "&lt;")
+        .replace('>', "&gt;")
+}
+
+#[cfg(test)]
+mod tests {
+    #[test]
+    fn canonical_markdown_renders_as_slack_mrkdwn() {
+        let reply = crate::slack::format_reply(
+            "## Summary\n**Done** with *one* [note](https://nuka.world) and ~~no~~ `**raw**` code",
+        );
+
+        assert_eq!(
+            reply,
+            "*Summary*\n*Done* with _one_ <https://nuka.world|note> and ~no~ `**raw**` code"
+        );
+    }
+
+    #[test]
+    fn code_fences_drop_language_and_keep_contents() {
+        let reply = crate::slack::format_reply("```rust\nlet x = **y**;\n```\n* item");
+        assert_eq!(reply, "```\nlet x = **y**;\n```\n* item");
+    }
+
+    #[test]
+    fn single_line_triple_backtick_span_is_not_a_fence() {
+        let reply = crate::slack::format_reply("```inline``` text\n**after**");
+        assert_eq!(reply, "```inline``` text\n*after*");
+    }
+
+    #[test]
+    fn fence_opener_keeps_non_language_text() {
+        let reply = crate::slack::format_reply("```see below\nx\n```");
+        assert_eq!(reply, "```\nsee below\nx\n```");
+    }
+
+    #[test]
+    fn headings_are_detected_on_the_whole_line() {
+        let reply = crate::slack::format_reply("## Use `foo` now\nsee `x` # not a heading");
+        assert_eq!(reply, "*Use `foo` now*\nsee `x` # not a heading");
+    }
+
+    #[test]
+    fn control_characters_are_escaped() {
+        let reply = crate::slack::format_reply(
+            "ping <!channel> & <@U123> if a < b `<b>`\n> [q](https://x.com/?a=1&b=2)",
+        );
+
+        assert_eq!(
+            reply,
+            "ping &lt;!channel&gt; &amp; &lt;@U123&gt; if a &lt; b `&lt;b&gt;`\n> <https://x.com/?a=1&amp;b=2|q>"
+        );
+    }
+
+    #[test]
+    fn nested_emphasis_and_parenthesised_urls_render() {
+        let reply = crate::slack::format_reply(
+            "***both*** and [a](http://x.com/a_(b)) **see [x](http://x.com/__y__)**",
+        );
+
+        assert_eq!(
+            reply,
+            "*_both_* and <http://x.com/a_(b)|a> *see <http://x.com/__y__|x>*"
+        );
+    }
+
+    #[test]
+    fn fence_closes_only_on_a_bare_backtick_run() {
+        let reply = crate::slack::format_reply("```\nline\n```python inside\n```\nafter");
+        assert_eq!(reply, "```\nline\n```python inside\n```\nafter");
+    }
+
+    #[test]
+    fn four_backtick_fence_keeps_nested_fences_as_code() {
+        let reply = crate::slack::format_reply("````\n```rust\ncode\n```\n````\n**after**");
+        assert_eq!(reply, "```\n```rust\ncode\n```\n```\n*after*");
+    }
+
+    #[test]
+    fn underscores_inside_identifiers_are_not_emphasis() {
+        let reply = crate::slack::format_reply("call foo__bar__baz or __this__");
+        assert_eq!(reply, "call foo__bar__baz or *this*");
+    }
+
+    #[test]
+    fn empty_and_quoted_headings_render() {
+        let reply = crate::slack::format_reply("## \n> ## quoted heading");
+        assert_eq!(reply, "## \n> *quoted heading*");
+    }
+}
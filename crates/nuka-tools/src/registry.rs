@@ -1,16 +1,74 @@
-pub struct ToolBindingSet(Vec<String>);
+pub const KNOWN_TOOL_IDS: &[&str] = &["codex", "claude_code", "git", "search_knowledge"];
+
+pub const DEFAULT_TOOL_BUNDLE: &[&str] = &["codex", "git", "search_knowledge"];
+
+pub const ROLE_TOOL_BUNDLES: &[(&str, &[&str])] = &[
+    ("researcher", &["search_knowledge"]),
+    ("reviewer", &["git", "search_knowledge"]),
+    ("coordinator", &["search_knowledge"]),
+    ("translator", &["search_knowledge"]),
+    ("poet", &[]),
+    ("ops", &["git", "codex"]),
+];
+
+#[derive(Debug, Clone, Default, PartialEq, Eq)]
+pub struct ToolBindingSet {
+    names: Vec<String>,
+    duplicates: Vec<String>,
+}
 
 impl ToolBindingSet {
     pub fn from_names<const N: usize>(names: [&str; N]) -> Self {
-        Self(names.into_iter().map(|name| name.to_string()).collect())
+        Self::from_slice(&names)
+    }
+
+    pub fn default_bundle() -> Self {
+        Self::from_slice(DEFAULT_TOOL_BUNDLE)
+    }
+
+    pub fn for_role(role: &str) -> Self {
+        let bundle = ROLE_TOOL_BUNDLES
+            .iter()
+            .find(|(bundle_role, _)| bundle_role.eq_ignore_ascii_case(role.trim()))
+            .map(|(_, names)| *names)
+            .unwrap_or(DEFAULT_TOOL_BUNDLE);
+
+        Self::from_slice(bundle)
+    }
+
+    fn from_slice(names: &[&str]) -> Self {
+        let mut set = Self::default();
+        for name in names {
+            set.insert(name);
+        }
+
+        set
+    }
+
+    pub fn insert(&mut self, name: &str) -> bool {
+        if self.contains(name) {
+            self.duplicates.push(name.to_string());
+            return false;
+        }
+
+        self.names.push(name.to_string());
+        true
+    }
+
+    pub fn contains(&self, name: &str) -> bool {
+        self.names.iter().any(|bound| bound == name)
+    }
+
+    pub fn duplicates(&self) -> &[String] {
+        &self.duplicates
     }
 
     pub fn len(&self) -> usize {
-        self.0.len()
+        self.names.len()
     }
 
     pub fn into_vec(self) -> Vec<String> {
-        self.0
+        self.names
     }
 }
 
@@ -21,4 +79,38 @@ mod tests {
         let bindings = crate::registry::ToolBindingSet::from_names(["codex", "git", "search_knowledge"]);
         assert_eq!(bindings.len(), 3);
     }
+
+    #[test]
+    fn duplicate_tool_names_keep_first_binding() {
+        let mut bindings = crate::registry::ToolBindingSet::from_names(["codex", "git", "codex"]);
+
+        assert!(!bindings.insert("git"));
+        assert!(bindings.insert("search_knowledge"));
+        assert_eq!(bindings.duplicates(), ["codex", "git"]);
+        assert_eq!(bindings.into_vec(), vec!["codex", "git", "search_knowledge"]);
+    }
+
+    #[test]
+    fn researcher_role_gets_configured_tool_bundle() {
+        let bindings = crate::registry::ToolBindingSet::for_role("Researcher");
+        assert_eq!(bindings.into_vec(), vec!["search_knowledge"]);
+    }
+
+    #[test]
+    fn role_tool_bundles_only_use_known_tools() {
+        for (role, names) in crate::registry::ROLE_TOOL_BUNDLES {
+            for name in *names {
+                assert!(
+                    crate::registry::KNOWN_TOOL_IDS.contains(name),
+                    "unknown tool {name} in {role} bundle"
+                );
+            }
+        }
+    }
+
+    #[test]
+    fn unknown_role_falls_back_to_default_tool_bundle() {
+        let bindings = crate::registry::ToolBindingSet::for_role("gardener");
+        assert_eq!(bindings.into_vec(), crate::registry::DEFAULT_TOOL_BUNDLE);
+    }
 }